@@ -0,0 +1,231 @@
+package internal
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	receiverv0alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v0alpha1"
+	receiverv1alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1"
+)
+
+// RegisterConversions wires every external Receiver version to the hub type
+// defined in this package via scheme.AddConversionFunc. Adding a new external
+// version only requires a pair of functions here, not a function per pair of
+// versions.
+func RegisterConversions(scheme *runtime.Scheme) error {
+	if err := scheme.AddConversionFunc((*receiverv0alpha1.Receiver)(nil), (*Receiver)(nil), func(a, b interface{}, s conversion.Scope) error {
+		return Convert_v0alpha1_Receiver_To_internal_Receiver(a.(*receiverv0alpha1.Receiver), b.(*Receiver))
+	}); err != nil {
+		return err
+	}
+	if err := scheme.AddConversionFunc((*Receiver)(nil), (*receiverv0alpha1.Receiver)(nil), func(a, b interface{}, s conversion.Scope) error {
+		return Convert_internal_Receiver_To_v0alpha1_Receiver(a.(*Receiver), b.(*receiverv0alpha1.Receiver))
+	}); err != nil {
+		return err
+	}
+
+	if err := scheme.AddConversionFunc((*receiverv1alpha1.Receiver)(nil), (*Receiver)(nil), func(a, b interface{}, s conversion.Scope) error {
+		return Convert_v1alpha1_Receiver_To_internal_Receiver(a.(*receiverv1alpha1.Receiver), b.(*Receiver))
+	}); err != nil {
+		return err
+	}
+	if err := scheme.AddConversionFunc((*Receiver)(nil), (*receiverv1alpha1.Receiver)(nil), func(a, b interface{}, s conversion.Scope) error {
+		return Convert_internal_Receiver_To_v1alpha1_Receiver(a.(*Receiver), b.(*receiverv1alpha1.Receiver))
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func Convert_v0alpha1_Receiver_To_internal_Receiver(in *receiverv0alpha1.Receiver, out *Receiver) error {
+	out.TypeMeta = in.TypeMeta
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = ReceiverSpec{
+		Title:        in.Spec.Title,
+		Integrations: convertV0alpha1IntegrationsToInternal(in.Spec.Integrations),
+		Disabled:     in.Spec.Disabled,
+	}
+	out.Status = ReceiverStatus{
+		OperatorStates:   convertV0alpha1OperatorStatesToInternal(in.Status.OperatorStates),
+		AdditionalFields: in.Status.AdditionalFields,
+	}
+	return nil
+}
+
+func Convert_internal_Receiver_To_v0alpha1_Receiver(in *Receiver, out *receiverv0alpha1.Receiver) error {
+	out.TypeMeta = in.TypeMeta
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = receiverv0alpha1.Spec{
+		Title:        in.Spec.Title,
+		Integrations: convertInternalIntegrationsToV0alpha1(in.Spec.Integrations),
+		Disabled:     in.Spec.Disabled,
+	}
+	out.Status = receiverv0alpha1.Status{
+		OperatorStates:   convertInternalOperatorStatesToV0alpha1(in.Status.OperatorStates),
+		AdditionalFields: in.Status.AdditionalFields,
+	}
+	return nil
+}
+
+func Convert_v1alpha1_Receiver_To_internal_Receiver(in *receiverv1alpha1.Receiver, out *Receiver) error {
+	out.TypeMeta = in.TypeMeta
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = ReceiverSpec{
+		Title:        in.Spec.Title,
+		Integrations: convertV1alpha1IntegrationsToInternal(in.Spec.Integrations),
+		Disabled:     in.Spec.Disabled,
+	}
+	out.Status = ReceiverStatus{
+		OperatorStates:   convertV1alpha1OperatorStatesToInternal(in.Status.OperatorStates),
+		AdditionalFields: in.Status.AdditionalFields,
+	}
+	return nil
+}
+
+func Convert_internal_Receiver_To_v1alpha1_Receiver(in *Receiver, out *receiverv1alpha1.Receiver) error {
+	out.TypeMeta = in.TypeMeta
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = receiverv1alpha1.Spec{
+		Title:        in.Spec.Title,
+		Integrations: convertInternalIntegrationsToV1alpha1(in.Spec.Integrations),
+		Disabled:     in.Spec.Disabled,
+	}
+	out.Status = receiverv1alpha1.Status{
+		OperatorStates:   convertInternalOperatorStatesToV1alpha1(in.Status.OperatorStates),
+		AdditionalFields: in.Status.AdditionalFields,
+	}
+	return nil
+}
+
+func convertV0alpha1IntegrationsToInternal(in []receiverv0alpha1.Integration) []ReceiverIntegration {
+	if in == nil {
+		return nil
+	}
+	out := make([]ReceiverIntegration, len(in))
+	for i, integration := range in {
+		out[i] = ReceiverIntegration{
+			UID:                   integration.Uid,
+			Type:                  integration.Type,
+			DisableResolveMessage: integration.DisableResolveMessage,
+			Settings:              integration.Settings,
+			SecureFields:          integration.SecureFields,
+		}
+	}
+	return out
+}
+
+func convertInternalIntegrationsToV0alpha1(in []ReceiverIntegration) []receiverv0alpha1.Integration {
+	if in == nil {
+		return nil
+	}
+	out := make([]receiverv0alpha1.Integration, len(in))
+	for i, integration := range in {
+		out[i] = receiverv0alpha1.Integration{
+			Uid:                   integration.UID,
+			Type:                  integration.Type,
+			DisableResolveMessage: integration.DisableResolveMessage,
+			Settings:              integration.Settings,
+			SecureFields:          integration.SecureFields,
+		}
+	}
+	return out
+}
+
+func convertV1alpha1IntegrationsToInternal(in []receiverv1alpha1.Integration) []ReceiverIntegration {
+	if in == nil {
+		return nil
+	}
+	out := make([]ReceiverIntegration, len(in))
+	for i, integration := range in {
+		out[i] = ReceiverIntegration{
+			UID:                   integration.Uid,
+			Type:                  integration.Type,
+			DisableResolveMessage: integration.DisableResolveMessage,
+			Settings:              integration.Settings,
+			SecureFields:          integration.SecureFields,
+		}
+	}
+	return out
+}
+
+func convertInternalIntegrationsToV1alpha1(in []ReceiverIntegration) []receiverv1alpha1.Integration {
+	if in == nil {
+		return nil
+	}
+	out := make([]receiverv1alpha1.Integration, len(in))
+	for i, integration := range in {
+		out[i] = receiverv1alpha1.Integration{
+			Uid:                   integration.UID,
+			Type:                  integration.Type,
+			DisableResolveMessage: integration.DisableResolveMessage,
+			Settings:              integration.Settings,
+			SecureFields:          integration.SecureFields,
+		}
+	}
+	return out
+}
+
+func convertV0alpha1OperatorStatesToInternal(in map[string]receiverv0alpha1.StatusOperatorState) map[string]ReceiverOperatorState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]ReceiverOperatorState, len(in))
+	for k, v := range in {
+		out[k] = ReceiverOperatorState{
+			LastEvaluation:   v.LastEvaluation,
+			State:            string(v.State),
+			DescriptiveState: v.DescriptiveState,
+			Details:          v.Details,
+		}
+	}
+	return out
+}
+
+func convertInternalOperatorStatesToV0alpha1(in map[string]ReceiverOperatorState) map[string]receiverv0alpha1.StatusOperatorState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]receiverv0alpha1.StatusOperatorState, len(in))
+	for k, v := range in {
+		out[k] = receiverv0alpha1.StatusOperatorState{
+			LastEvaluation:   v.LastEvaluation,
+			State:            receiverv0alpha1.StatusOperatorStateState(v.State),
+			DescriptiveState: v.DescriptiveState,
+			Details:          v.Details,
+		}
+	}
+	return out
+}
+
+func convertV1alpha1OperatorStatesToInternal(in map[string]receiverv1alpha1.StatusOperatorState) map[string]ReceiverOperatorState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]ReceiverOperatorState, len(in))
+	for k, v := range in {
+		out[k] = ReceiverOperatorState{
+			LastEvaluation:   v.LastEvaluation,
+			State:            string(v.State),
+			DescriptiveState: v.DescriptiveState,
+			Details:          v.Details,
+		}
+	}
+	return out
+}
+
+func convertInternalOperatorStatesToV1alpha1(in map[string]ReceiverOperatorState) map[string]receiverv1alpha1.StatusOperatorState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]receiverv1alpha1.StatusOperatorState, len(in))
+	for k, v := range in {
+		out[k] = receiverv1alpha1.StatusOperatorState{
+			LastEvaluation:   v.LastEvaluation,
+			State:            receiverv1alpha1.StatusOperatorStateState(v.State),
+			DescriptiveState: v.DescriptiveState,
+			Details:          v.Details,
+		}
+	}
+	return out
+}
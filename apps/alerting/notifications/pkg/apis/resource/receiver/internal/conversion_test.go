@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	receiverv0alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v0alpha1"
+	receiverv1alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1"
+)
+
+func FuzzConvertV0alpha1ReceiverRoundTrip(f *testing.F) {
+	f.Add("receiver-1", "ns-1", "title-1", "email")
+	f.Fuzz(func(t *testing.T, name, namespace, title, integrationType string) {
+		in := &receiverv0alpha1.Receiver{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: receiverv0alpha1.Spec{
+				Title: title,
+				Integrations: []receiverv0alpha1.Integration{
+					{Type: integrationType, Settings: map[string]interface{}{}},
+				},
+			},
+		}
+
+		hub := &Receiver{}
+		if err := Convert_v0alpha1_Receiver_To_internal_Receiver(in, hub); err != nil {
+			t.Fatalf("to hub: %v", err)
+		}
+
+		out := &receiverv0alpha1.Receiver{}
+		if err := Convert_internal_Receiver_To_v0alpha1_Receiver(hub, out); err != nil {
+			t.Fatalf("from hub: %v", err)
+		}
+
+		if diff := cmp.Diff(in.Spec, out.Spec); diff != "" {
+			t.Errorf("round trip through the hub changed Spec (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func FuzzConvertV1alpha1ReceiverRoundTrip(f *testing.F) {
+	f.Add("receiver-1", "ns-1", "title-1", "email")
+	f.Fuzz(func(t *testing.T, name, namespace, title, integrationType string) {
+		in := &receiverv1alpha1.Receiver{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: receiverv1alpha1.Spec{
+				Title: title,
+				Integrations: []receiverv1alpha1.Integration{
+					{Type: integrationType, Settings: map[string]interface{}{}},
+				},
+			},
+		}
+
+		hub := &Receiver{}
+		if err := Convert_v1alpha1_Receiver_To_internal_Receiver(in, hub); err != nil {
+			t.Fatalf("to hub: %v", err)
+		}
+
+		out := &receiverv1alpha1.Receiver{}
+		if err := Convert_internal_Receiver_To_v1alpha1_Receiver(hub, out); err != nil {
+			t.Fatalf("from hub: %v", err)
+		}
+
+		if diff := cmp.Diff(in.Spec, out.Spec); diff != "" {
+			t.Errorf("round trip through the hub changed Spec (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+// Package internal holds the hub (internal) representation of the Receiver kind.
+//
+// Every external version (v0alpha1, v1alpha1, ...) converts to and from this
+// type via the conversion functions registered in conversion.go, so adding a
+// new external version only requires a pair of conversion funcs rather than
+// O(n^2) conversions between every version.
+package internal
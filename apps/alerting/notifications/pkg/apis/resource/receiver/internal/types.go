@@ -0,0 +1,177 @@
+package internal
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Receiver is the internal (hub) representation of a Receiver. External
+// versions convert to and from this type; it is never served directly.
+type Receiver struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReceiverSpec   `json:"spec"`
+	Status ReceiverStatus `json:"status,omitempty"`
+}
+
+func (o *Receiver) DeepCopyObject() runtime.Object {
+	return o.DeepCopy()
+}
+
+// DeepCopy returns a deep copy of o, or nil if o is nil.
+func (o *Receiver) DeepCopy() *Receiver {
+	if o == nil {
+		return nil
+	}
+	cpy := new(Receiver)
+	o.DeepCopyInto(cpy)
+	return cpy
+}
+
+func (o *Receiver) DeepCopyInto(out *Receiver) {
+	*out = *o
+	out.TypeMeta = o.TypeMeta
+	o.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	o.Spec.DeepCopyInto(&out.Spec)
+	o.Status.DeepCopyInto(&out.Status)
+}
+
+// ReceiverList is the internal (hub) representation of a list of Receivers.
+type ReceiverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Receiver `json:"items"`
+}
+
+func (o *ReceiverList) DeepCopyObject() runtime.Object {
+	return o.DeepCopy()
+}
+
+// DeepCopy returns a deep copy of o, or nil if o is nil.
+func (o *ReceiverList) DeepCopy() *ReceiverList {
+	if o == nil {
+		return nil
+	}
+	cpy := new(ReceiverList)
+	o.DeepCopyInto(cpy)
+	return cpy
+}
+
+func (o *ReceiverList) DeepCopyInto(out *ReceiverList) {
+	*out = *o
+	out.TypeMeta = o.TypeMeta
+	o.ListMeta.DeepCopyInto(&out.ListMeta)
+	if o.Items != nil {
+		out.Items = make([]Receiver, len(o.Items))
+		for i := range o.Items {
+			o.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// ReceiverSpec is the internal representation of Receiver.Spec. It is a
+// superset of every external version's spec fields seen so far.
+type ReceiverSpec struct {
+	Title        string                `json:"title"`
+	Integrations []ReceiverIntegration `json:"integrations"`
+	Disabled     bool                  `json:"disabled,omitempty"`
+}
+
+func (s *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
+	*out = *s
+	if s.Integrations != nil {
+		out.Integrations = make([]ReceiverIntegration, len(s.Integrations))
+		for i := range s.Integrations {
+			s.Integrations[i].DeepCopyInto(&out.Integrations[i])
+		}
+	}
+}
+
+type ReceiverIntegration struct {
+	UID                   string                 `json:"uid,omitempty"`
+	Type                  string                 `json:"type"`
+	DisableResolveMessage *bool                  `json:"disableResolveMessage,omitempty"`
+	Settings              map[string]interface{} `json:"settings"`
+	SecureFields          map[string]bool        `json:"secureFields,omitempty"`
+}
+
+func (i *ReceiverIntegration) DeepCopyInto(out *ReceiverIntegration) {
+	*out = *i
+	if i.DisableResolveMessage != nil {
+		out.DisableResolveMessage = new(bool)
+		*out.DisableResolveMessage = *i.DisableResolveMessage
+	}
+	out.Settings = deepCopyInterfaceMap(i.Settings)
+	if i.SecureFields != nil {
+		out.SecureFields = make(map[string]bool, len(i.SecureFields))
+		for k, v := range i.SecureFields {
+			out.SecureFields[k] = v
+		}
+	}
+}
+
+// ReceiverStatus is the internal representation of Receiver.Status.
+type ReceiverStatus struct {
+	OperatorStates   map[string]ReceiverOperatorState `json:"operatorStates,omitempty"`
+	AdditionalFields map[string]interface{}           `json:"additionalFields,omitempty"`
+}
+
+func (s *ReceiverStatus) DeepCopyInto(out *ReceiverStatus) {
+	*out = *s
+	if s.OperatorStates != nil {
+		out.OperatorStates = make(map[string]ReceiverOperatorState, len(s.OperatorStates))
+		for k, v := range s.OperatorStates {
+			var cpy ReceiverOperatorState
+			v.DeepCopyInto(&cpy)
+			out.OperatorStates[k] = cpy
+		}
+	}
+	out.AdditionalFields = deepCopyInterfaceMap(s.AdditionalFields)
+}
+
+type ReceiverOperatorState struct {
+	LastEvaluation   string                 `json:"lastEvaluation"`
+	State            string                 `json:"state"`
+	DescriptiveState *string                `json:"descriptiveState,omitempty"`
+	Details          map[string]interface{} `json:"details,omitempty"`
+}
+
+func (s *ReceiverOperatorState) DeepCopyInto(out *ReceiverOperatorState) {
+	*out = *s
+	if s.DescriptiveState != nil {
+		out.DescriptiveState = new(string)
+		*out.DescriptiveState = *s.DescriptiveState
+	}
+	out.Details = deepCopyInterfaceMap(s.Details)
+}
+
+// deepCopyInterfaceMap deep copies a map[string]interface{} whose values are
+// JSON-like (map[string]interface{}, []interface{}, or scalars), which is the
+// only shape Settings/AdditionalFields/Details ever hold.
+func deepCopyInterfaceMap(in map[string]interface{}) map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = deepCopyJSONValue(v)
+	}
+	return out
+}
+
+func deepCopyJSONValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return deepCopyInterfaceMap(val)
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = deepCopyJSONValue(item)
+		}
+		return out
+	default:
+		return val
+	}
+}
@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"github.com/grafana/grafana-app-sdk/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	GROUP      = "notifications.alerting.grafana.app"
+	VERSION    = "v1alpha1"
+	APIVERSION = GROUP + "/" + VERSION
+)
+
+var ReceiverKind = resource.Kind{
+	Schema: ReceiverResourceInfo.GetGroupVersionKind(),
+}
+
+var ReceiverResourceInfo = resource.NewResourceInfo(GROUP, VERSION,
+	"receivers", "receiver", "Receiver",
+	func() resource.Object { return &Receiver{} },
+	func() resource.ListObject { return &ReceiverList{} },
+	resource.WithKind(schema.GroupVersionKind{Group: GROUP, Version: VERSION, Kind: "Receiver"}),
+)
+
+var SchemeGroupVersion = schema.GroupVersion{Group: GROUP, Version: VERSION}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+var (
+	SchemeBuilder      runtime.SchemeBuilder
+	localSchemeBuilder = &SchemeBuilder
+	AddToScheme        = localSchemeBuilder.AddToScheme
+)
+
+func init() {
+	localSchemeBuilder.Register(AddKnownTypes)
+}
+
+// AddKnownTypes registers known types to the given scheme
+func AddKnownTypes(scheme *runtime.Scheme, version string) {
+	scheme.AddKnownTypes(schema.GroupVersion{Group: GROUP, Version: version},
+		&Receiver{},
+		&ReceiverList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+}
@@ -0,0 +1,152 @@
+package receiver
+
+import (
+	"fmt"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/registry/rest"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	common "k8s.io/kube-openapi/pkg/common"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/internal"
+	receiverv0alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v0alpha1"
+	receiverv1alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1"
+	"github.com/grafana/grafana/pkg/registry/apis/alerting/receiver/reststorage"
+	sharedreststorage "github.com/grafana/grafana/pkg/registry/apis/reststorage"
+	"github.com/grafana/grafana/pkg/services/apiserver/builder"
+	"github.com/grafana/grafana/pkg/services/featuremgmt"
+)
+
+var _ builder.APIGroupBuilder = (*ReceiverAPIBuilder)(nil)
+
+// GroupPriorityMinimum is this group's floor in the aggregation layer's
+// cross-group APIService ordering (apiregistrationv1.APIServiceSpec's
+// GroupPriorityMinimum), the same value registered for every version of
+// this group. It's deliberately distinct from VersionPriority below, which
+// only orders versions within this group -- the same split kube-aggregator
+// itself makes between the two fields.
+const GroupPriorityMinimum = 10000
+
+// versionPriorityByGroupVersion ranks this group's own versions against each other,
+// highest first, mirroring the order passed to scheme.SetVersionPriority
+// in InstallSchema. Registration code that creates this group's
+// APIService objects reads both via GetAPIServicePriority.
+var versionPriorityByGroupVersion = map[schema.GroupVersion]int32{
+	receiverv1alpha1.SchemeGroupVersion: 15,
+	receiverv0alpha1.SchemeGroupVersion: 10,
+}
+
+type ReceiverAPIBuilder struct {
+	features featuremgmt.FeatureToggles
+	store    reststorage.Store
+}
+
+func NewReceiverAPIBuilder(features featuremgmt.FeatureToggles, store reststorage.Store) *ReceiverAPIBuilder {
+	return &ReceiverAPIBuilder{features: features, store: store}
+}
+
+func RegisterAPIService(apiregistration builder.APIRegistrar, features featuremgmt.FeatureToggles, store reststorage.Store) *ReceiverAPIBuilder {
+	b := NewReceiverAPIBuilder(features, store)
+	apiregistration.RegisterAPI(b)
+	return b
+}
+
+// GetGroupVersion returns the preferred group/version, i.e. the one returned
+// to a client that asks for the group without pinning a version.
+func (b *ReceiverAPIBuilder) GetGroupVersion() schema.GroupVersion {
+	return receiverv1alpha1.SchemeGroupVersion
+}
+
+// InstallSchema registers every served version of Receiver plus the internal
+// (hub) representation, and sets the version priority so that v1alpha1 is
+// preferred over v0alpha1 until a v1 lands and takes over that spot.
+func (b *ReceiverAPIBuilder) InstallSchema(scheme *runtime.Scheme) error {
+	receiverv0alpha1.AddKnownTypes(scheme, receiverv0alpha1.VERSION)
+	receiverv1alpha1.AddKnownTypes(scheme, receiverv1alpha1.VERSION)
+
+	// Link the internal/hub type so PATCH (server-side-apply) doesn't hit
+	// "no kind is registered for the type runtime.APIVersionInternal".
+	scheme.AddKnownTypes(schema.GroupVersion{Group: receiverv1alpha1.GROUP, Version: runtime.APIVersionInternal},
+		&internal.Receiver{},
+		&internal.ReceiverList{},
+	)
+
+	if err := internal.RegisterConversions(scheme); err != nil {
+		return fmt.Errorf("register receiver conversions: %w", err)
+	}
+
+	metav1.AddToGroupVersion(scheme, receiverv1alpha1.SchemeGroupVersion)
+
+	// Highest priority first: v1 will be inserted ahead of v1alpha1 once it exists.
+	if err := scheme.SetVersionPriority(receiverv1alpha1.SchemeGroupVersion, receiverv0alpha1.SchemeGroupVersion); err != nil {
+		return fmt.Errorf("scheme set version priority: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAPIGroupInfo registers the same storage under every served version.
+// Storage itself only ever reads/writes the internal (hub) type; the
+// apiserver's per-version codec does the conversion to/from v0alpha1 and
+// v1alpha1 using the functions registered in InstallSchema.
+func (b *ReceiverAPIBuilder) UpdateAPIGroupInfo(apiGroupInfo *genericapiserver.APIGroupInfo, _ builder.APIGroupOptions) error {
+	var receiverCRUDL rest.Storage = reststorage.NewGenericStorage(b.store)
+	if schema, err := b.structuralSchema(); err != nil {
+		return fmt.Errorf("receiver structural schema: %w", err)
+	} else if schema != nil {
+		receiverCRUDL = sharedreststorage.WithStructuralSchema(receiverCRUDL, sharedreststorage.StructuralSchemaOptions{
+			Schema:                schema,
+			StrictFieldValidation: b.features.IsEnabledGlobally(featuremgmt.FlagSecretsManagementStrictFieldValidation),
+		})
+	}
+
+	storage := map[string]rest.Storage{
+		receiverv1alpha1.ReceiverResourceInfo.StoragePath(): receiverCRUDL,
+	}
+
+	apiGroupInfo.VersionedResourcesStorageMap[receiverv0alpha1.VERSION] = storage
+	apiGroupInfo.VersionedResourcesStorageMap[receiverv1alpha1.VERSION] = storage
+	return nil
+}
+
+// structuralSchema derives the structural schema Receiver is validated,
+// pruned and defaulted against from its generated OpenAPI v3 definition.
+func (b *ReceiverAPIBuilder) structuralSchema() (*structuralschema.Structural, error) {
+	defs := receiverv1alpha1.GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.Ref{}
+	})
+	def, ok := defs["github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1.Receiver"]
+	if !ok {
+		return nil, nil
+	}
+	return sharedreststorage.NewStructuralSchema(&def.Schema)
+}
+
+func (b *ReceiverAPIBuilder) GetOpenAPIDefinitions() common.GetOpenAPIDefinitions {
+	return receiverv1alpha1.GetOpenAPIDefinitions
+}
+
+// GetAuthorizer defers to the default authorizer; Receiver carries no
+// secrets itself so it doesn't need SecureValue's per-subresource treatment.
+func (b *ReceiverAPIBuilder) GetAuthorizer() authorizer.Authorizer {
+	return nil
+}
+
+func (b *ReceiverAPIBuilder) GetAPIRoutes() *builder.APIRoutes {
+	return nil
+}
+
+// GetAPIServicePriority reports the two priorities the aggregation layer
+// registers an APIService under for groupVersion: GroupPriorityMinimum,
+// shared by every version of this group, and VersionPriority, which ranks
+// groupVersion against this group's own other versions. An optional hook
+// implemented the same way GetAuthorizer/GetAPIRoutes are: registration
+// code type-asserts for it and falls back to a default when absent.
+func (b *ReceiverAPIBuilder) GetAPIServicePriority(groupVersion schema.GroupVersion) (groupPriorityMinimum, versionPriority int32) {
+	return GroupPriorityMinimum, versionPriorityByGroupVersion[groupVersion]
+}
@@ -0,0 +1,29 @@
+package receiver
+
+import (
+	"testing"
+
+	receiverv0alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v0alpha1"
+	receiverv1alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1"
+)
+
+func TestGetAPIServicePriority(t *testing.T) {
+	b := &ReceiverAPIBuilder{}
+
+	groupPriorityMinimum, v1alpha1Priority := b.GetAPIServicePriority(receiverv1alpha1.SchemeGroupVersion)
+	if groupPriorityMinimum != GroupPriorityMinimum {
+		t.Fatalf("expected GroupPriorityMinimum %d, got %d", GroupPriorityMinimum, groupPriorityMinimum)
+	}
+
+	_, v0alpha1Priority := b.GetAPIServicePriority(receiverv0alpha1.SchemeGroupVersion)
+	if v1alpha1Priority <= v0alpha1Priority {
+		t.Fatalf("expected v1alpha1 (%d) to outrank v0alpha1 (%d)", v1alpha1Priority, v0alpha1Priority)
+	}
+
+	// GroupPriorityMinimum is the same across every version of this group --
+	// only VersionPriority should differ between them.
+	v0GroupPriorityMinimum, _ := b.GetAPIServicePriority(receiverv0alpha1.SchemeGroupVersion)
+	if v0GroupPriorityMinimum != groupPriorityMinimum {
+		t.Fatalf("expected GroupPriorityMinimum to be shared across versions, got %d and %d", groupPriorityMinimum, v0GroupPriorityMinimum)
+	}
+}
@@ -0,0 +1,176 @@
+package reststorage
+
+import (
+	"context"
+	"fmt"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/internal"
+	sharedreststorage "github.com/grafana/grafana/pkg/registry/apis/reststorage"
+)
+
+// Store is the minimal persistence interface GenericStorage needs. It works
+// against the hub (internal) representation, so it is shared by every
+// external version of Receiver: the apiserver's per-version codecs handle
+// converting requests and responses via the conversion funcs registered in
+// the internal package.
+type Store interface {
+	Get(ctx context.Context, namespace, name string) (*internal.Receiver, error)
+	List(ctx context.Context, namespace string, options metav1.ListOptions) (*internal.ReceiverList, error)
+	Create(ctx context.Context, obj *internal.Receiver) (*internal.Receiver, error)
+	Update(ctx context.Context, obj *internal.Receiver) (*internal.Receiver, error)
+	Delete(ctx context.Context, namespace, name string) error
+}
+
+// GenericStorage implements rest.Storage for Receiver against the hub type.
+// It is registered once per external version under VersionedResourcesStorageMap;
+// the same instance is reused for every version rather than wrapped, since the
+// scheme's registered conversion functions already do the per-version work.
+type GenericStorage struct {
+	store Store
+}
+
+func NewGenericStorage(store Store) *GenericStorage {
+	return &GenericStorage{store: store}
+}
+
+func (s *GenericStorage) New() runtime.Object {
+	return &internal.Receiver{}
+}
+
+func (s *GenericStorage) NewList() runtime.Object {
+	return &internal.ReceiverList{}
+}
+
+func (s *GenericStorage) Destroy() {}
+
+var (
+	_ rest.Storage         = (*GenericStorage)(nil)
+	_ rest.Scoper          = (*GenericStorage)(nil)
+	_ rest.Getter          = (*GenericStorage)(nil)
+	_ rest.Lister          = (*GenericStorage)(nil)
+	_ rest.Creater         = (*GenericStorage)(nil)
+	_ rest.Updater         = (*GenericStorage)(nil)
+	_ rest.GracefulDeleter = (*GenericStorage)(nil)
+)
+
+func (s *GenericStorage) NamespaceScoped() bool {
+	return true
+}
+
+// Get returns the Receiver, or its PartialObjectMetadata projection when the
+// caller's Accept header asked for `as=PartialObjectMetadata`, so controllers
+// that only need labels/annotations/ownerRefs never pull Spec over the wire.
+func (s *GenericStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := s.store.Get(ctx, request.NamespaceValue(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+	if !sharedreststorage.AcceptsPartialObjectMetadata(ctx) {
+		return obj, nil
+	}
+	return sharedreststorage.ToPartialObjectMetadata(obj)
+}
+
+// List returns Receivers, honoring the same `as=PartialObjectMetadata` Accept
+// header as Get, applied to every item.
+func (s *GenericStorage) List(ctx context.Context, _ *metainternalversion.ListOptions) (runtime.Object, error) {
+	list, err := s.store.List(ctx, request.NamespaceValue(ctx), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !sharedreststorage.AcceptsPartialObjectMetadata(ctx) {
+		return list, nil
+	}
+
+	out := &metav1.PartialObjectMetadataList{}
+	for i := range list.Items {
+		partial, err := sharedreststorage.ToPartialObjectMetadata(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, *partial)
+	}
+	return out, nil
+}
+
+// Create persists a new Receiver, letting Create/Update/PATCH (server-side-apply)
+// actually reach s.store instead of only the read paths above.
+func (s *GenericStorage) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	receiver, ok := obj.(*internal.Receiver)
+	if !ok {
+		return nil, fmt.Errorf("expected *internal.Receiver, got %T", obj)
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, receiver); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.Create(ctx, receiver)
+}
+
+// Update applies objInfo to the current Receiver (or to nil, when
+// forceAllowCreate is set and name doesn't exist yet) and persists the
+// result, creating it if it didn't already exist.
+func (s *GenericStorage) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, _ *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	old, err := s.store.Get(ctx, request.NamespaceValue(ctx), name)
+	var oldObj runtime.Object
+	if err != nil {
+		if !forceAllowCreate {
+			return nil, false, err
+		}
+		old = nil
+	} else {
+		oldObj = old
+	}
+
+	newObj, err := objInfo.UpdatedObject(ctx, oldObj)
+	if err != nil {
+		return nil, false, err
+	}
+	receiver, ok := newObj.(*internal.Receiver)
+	if !ok {
+		return nil, false, fmt.Errorf("expected *internal.Receiver, got %T", newObj)
+	}
+
+	if old == nil {
+		if createValidation != nil {
+			if err := createValidation(ctx, receiver); err != nil {
+				return nil, false, err
+			}
+		}
+		created, err := s.store.Create(ctx, receiver)
+		return created, true, err
+	}
+
+	if updateValidation != nil {
+		if err := updateValidation(ctx, receiver, old); err != nil {
+			return nil, false, err
+		}
+	}
+	updated, err := s.store.Update(ctx, receiver)
+	return updated, false, err
+}
+
+// Delete removes the Receiver, returning the object that was deleted so
+// clients (and admission) can see what went away.
+func (s *GenericStorage) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, _ *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	obj, err := s.store.Get(ctx, request.NamespaceValue(ctx), name)
+	if err != nil {
+		return nil, false, err
+	}
+	if deleteValidation != nil {
+		if err := deleteValidation(ctx, obj); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := s.store.Delete(ctx, request.NamespaceValue(ctx), name); err != nil {
+		return nil, false, err
+	}
+	return obj, true, nil
+}
@@ -0,0 +1,110 @@
+// Package reststorage holds helpers shared across the per-group REST storage
+// packages (pkg/registry/apis/*/reststorage), rather than duplicating them in
+// each group.
+package reststorage
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+)
+
+// MetadataOnlyResourceInfo is implemented by resource infos/kinds that can
+// describe their own group/version/kind. Every app-sdk generated resource
+// info already exposes this, so storage wrappers can opt into the
+// PartialObjectMetadata projection uniformly, without per-Kind code.
+type MetadataOnlyResourceInfo interface {
+	GroupVersionKind() schema.GroupVersionKind
+}
+
+// PartialObjectMetadataGVK is the GVK the projection is requested under via
+// the Accept header, regardless of the underlying resource's own group/version.
+var PartialObjectMetadataGVK = schema.GroupVersionKind{
+	Group:   "meta.k8s.io",
+	Version: "v1",
+	Kind:    "PartialObjectMetadata",
+}
+
+// AcceptHeaderFilter stashes the Accept header onto the request context so
+// rest.Storage implementations further down the chain can see it without
+// threading *http.Request through every CRUDL method. Install it via
+// InstallAcceptHeaderFilter rather than wiring it up by hand.
+func AcceptHeaderFilter(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithAcceptHeader(r.Context(), r.Header.Get("Accept"))
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// InstallAcceptHeaderFilter wraps cfg's handler chain with AcceptHeaderFilter,
+// ahead of whatever chain genericapiserver itself builds, so every request
+// reaching a rest.Storage implementation has its Accept header stashed on
+// ctx before AcceptsPartialObjectMetadata is ever called.
+func InstallAcceptHeaderFilter(cfg *genericapiserver.Config) {
+	next := cfg.BuildHandlerChainFunc
+	cfg.BuildHandlerChainFunc = func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		return AcceptHeaderFilter(next(apiHandler, c))
+	}
+}
+
+type acceptHeaderKey struct{}
+
+// WithAcceptHeader stashes the raw Accept header on ctx. The apiserver's
+// request filter chain calls this before invoking storage, so Get/List
+// implementations can see it without threading *http.Request through the
+// rest.Storage interfaces.
+func WithAcceptHeader(ctx context.Context, accept string) context.Context {
+	return context.WithValue(ctx, acceptHeaderKey{}, accept)
+}
+
+// AcceptsPartialObjectMetadata reports whether ctx's Accept header asked for
+// the `as=PartialObjectMetadata;g=meta.k8s.io;v=v1` projection, the same one
+// controller-runtime's metadata-only client sends.
+func AcceptsPartialObjectMetadata(ctx context.Context) bool {
+	accept, _ := ctx.Value(acceptHeaderKey{}).(string)
+	for _, part := range strings.Split(accept, ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if params["as"] == "PartialObjectMetadata" && params["g"] == "meta.k8s.io" && params["v"] == "v1" {
+			return true
+		}
+	}
+	return false
+}
+
+// ToPartialObjectMetadata strips everything but ObjectMeta from obj, the
+// server-side equivalent of what a metadata-only informer decodes client-side.
+func ToPartialObjectMetadata(obj runtime.Object) (*metav1.PartialObjectMetadata, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	out := &metav1.PartialObjectMetadata{}
+	// Always the fixed envelope GVK, not obj's own: internal (hub) objects
+	// usually carry no TypeMeta at all, and even when they do, a client that
+	// asked for this projection expects meta.k8s.io/v1, Kind=PartialObjectMetadata
+	// back, the same thing a real apiserver returns for any resource.
+	out.APIVersion = PartialObjectMetadataGVK.GroupVersion().String()
+	out.Kind = PartialObjectMetadataGVK.Kind
+	out.Name = accessor.GetName()
+	out.Namespace = accessor.GetNamespace()
+	out.UID = accessor.GetUID()
+	out.ResourceVersion = accessor.GetResourceVersion()
+	out.Generation = accessor.GetGeneration()
+	out.CreationTimestamp = accessor.GetCreationTimestamp()
+	out.DeletionTimestamp = accessor.GetDeletionTimestamp()
+	out.Labels = accessor.GetLabels()
+	out.Annotations = accessor.GetAnnotations()
+	out.OwnerReferences = accessor.GetOwnerReferences()
+	out.Finalizers = accessor.GetFinalizers()
+	return out, nil
+}
@@ -0,0 +1,195 @@
+package reststorage
+
+import (
+	"context"
+	"fmt"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource/schemaobjectmeta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// StructuralSchemaOptions configures [WithStructuralSchema].
+type StructuralSchemaOptions struct {
+	// Schema is the structural schema derived from the Kind's OpenAPI v3
+	// definition (see NewStructuralSchema).
+	Schema *structuralschema.Structural
+	// StrictFieldValidation rejects fields that aren't present in Schema
+	// instead of silently pruning them, mirroring CRDs' `fieldValidation=Strict`.
+	StrictFieldValidation bool
+}
+
+// NewStructuralSchema converts an OpenAPI v3 schema (as returned by a Kind's
+// generated GetOpenAPIDefinitions) into the structural form the
+// apiextensions-apiserver pruning/defaulting/validation packages operate on.
+func NewStructuralSchema(openAPISchema *spec.Schema) (*structuralschema.Structural, error) {
+	return structuralschema.NewStructural(openAPISchema)
+}
+
+// WithStructuralSchema wraps storage with the same pipeline
+// apiextensions-apiserver runs for CustomResourceDefinitions: prune fields
+// that aren't part of the schema, apply `default:` values, validate the
+// result, and optionally reject unknown fields outright. It's reusable
+// across Kinds (SecureValue, Receiver, ...) that provide a schema.
+func WithStructuralSchema(storage rest.Storage, opts StructuralSchemaOptions) rest.Storage {
+	return &structuralSchemaStorage{Storage: storage, opts: opts}
+}
+
+type structuralSchemaStorage struct {
+	rest.Storage
+	opts StructuralSchemaOptions
+}
+
+var (
+	_ rest.Creater = (*structuralSchemaStorage)(nil)
+	_ rest.Updater = (*structuralSchemaStorage)(nil)
+)
+
+func (s *structuralSchemaStorage) Create(ctx context.Context, obj runtime.Object, validate rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	creater, ok := s.Storage.(rest.Creater)
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement rest.Creater", s.Storage)
+	}
+	if err := s.applySchema(obj); err != nil {
+		return nil, err
+	}
+	return creater.Create(ctx, obj, validate, options)
+}
+
+func (s *structuralSchemaStorage) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	updater, ok := s.Storage.(rest.Updater)
+	if !ok {
+		return nil, false, fmt.Errorf("%T does not implement rest.Updater", s.Storage)
+	}
+	return updater.Update(ctx, name, &schemaCheckedUpdatedObjectInfo{UpdatedObjectInfo: objInfo, storage: s}, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// applySchema prunes fields not present in the schema, defaults what's left,
+// and validates the result, rejecting unknown fields outright when
+// StrictFieldValidation is set instead of silently dropping them.
+func (s *structuralSchemaStorage) applySchema(obj runtime.Object) error {
+	unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	if s.opts.StrictFieldValidation {
+		pruned := deepCopyJSONMap(unstructuredObj)
+		pruning.Prune(pruned, s.opts.Schema, false)
+		if unknown := firstUnknownField("", unstructuredObj, pruned); unknown != "" {
+			return fmt.Errorf("strict field validation failed: unknown field %q", unknown)
+		}
+	}
+
+	pruning.Prune(unstructuredObj, s.opts.Schema, false)
+	defaulting.Default(unstructuredObj, s.opts.Schema)
+
+	if errs := schemaobjectmeta.Validate(nil, unstructuredObj, false); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := apiservervalidation.ValidateCustomResource(nil, unstructuredObj, apiservervalidation.NewSchemaValidatorFromOpenAPI(s.opts.Schema)); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj, obj)
+}
+
+// firstUnknownField compares full against pruned (full run through
+// pruning.Prune) and returns the dotted path of the first field present in
+// full but absent from pruned, i.e. the first field outside the schema. It
+// recurses into both object and array-of-object values, since pruning.Prune
+// descends into array items too (e.g. spec.integrations[*].bogus).
+func firstUnknownField(path string, full, pruned map[string]interface{}) string {
+	for k, v := range full {
+		fieldPath := k
+		if path != "" {
+			fieldPath = path + "." + k
+		}
+		prunedVal, ok := pruned[k]
+		if !ok {
+			return fieldPath
+		}
+		if unknown := firstUnknownFieldValue(fieldPath, v, prunedVal); unknown != "" {
+			return unknown
+		}
+	}
+	return ""
+}
+
+// firstUnknownFieldValue is firstUnknownField's per-value half: it descends
+// into a single field's value, whether that value is an object, an array of
+// objects, or a scalar (where there's nothing further to check).
+func firstUnknownFieldValue(path string, full, pruned interface{}) string {
+	switch fullVal := full.(type) {
+	case map[string]interface{}:
+		prunedVal, ok := pruned.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		return firstUnknownField(path, fullVal, prunedVal)
+	case []interface{}:
+		prunedVal, ok := pruned.([]interface{})
+		if !ok || len(prunedVal) != len(fullVal) {
+			return ""
+		}
+		for i := range fullVal {
+			if unknown := firstUnknownFieldValue(fmt.Sprintf("%s[%d]", path, i), fullVal[i], prunedVal[i]); unknown != "" {
+				return unknown
+			}
+		}
+	}
+	return ""
+}
+
+// deepCopyJSONMap deep copies a map decoded from JSON, whose values are only
+// ever other such maps, []interface{} slices of them, or scalars. pruning.Prune
+// mutates in place, so without a real deep copy here, pruning the "pruned" side
+// in strict-validation mode would mutate the very maps/slices "full" shares,
+// making firstUnknownField's comparison always see them as equal.
+func deepCopyJSONMap(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = deepCopyJSONValue(v)
+	}
+	return out
+}
+
+func deepCopyJSONValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return deepCopyJSONMap(val)
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = deepCopyJSONValue(item)
+		}
+		return out
+	default:
+		return val
+	}
+}
+
+// schemaCheckedUpdatedObjectInfo re-applies applySchema to the new object an
+// Update produces, the same way Create does, before the storage layer
+// persists it.
+type schemaCheckedUpdatedObjectInfo struct {
+	rest.UpdatedObjectInfo
+	storage *structuralSchemaStorage
+}
+
+func (i *schemaCheckedUpdatedObjectInfo) UpdatedObject(ctx context.Context, oldObj runtime.Object) (runtime.Object, error) {
+	newObj, err := i.UpdatedObjectInfo.UpdatedObject(ctx, oldObj)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.storage.applySchema(newObj); err != nil {
+		return nil, err
+	}
+	return newObj, nil
+}
@@ -0,0 +1,65 @@
+package reststorage
+
+import (
+	"context"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/registry/rest"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/internal"
+	receiverv1alpha1 "github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1"
+)
+
+// receiverSchema builds the same structural schema ReceiverAPIBuilder derives
+// in production, from the real generated OpenAPI definition, so this test
+// actually exercises the lowercase JSON keys Receiver's json tags must match.
+func receiverSchema(t *testing.T) *structuralschema.Structural {
+	t.Helper()
+	defs := receiverv1alpha1.GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.Ref{}
+	})
+	def, ok := defs["github.com/grafana/grafana/apps/alerting/notifications/pkg/apis/resource/receiver/v1alpha1.Receiver"]
+	if !ok {
+		t.Fatal("missing Receiver OpenAPI definition")
+	}
+	s, err := NewStructuralSchema(&def.Schema)
+	if err != nil {
+		t.Fatalf("NewStructuralSchema: %v", err)
+	}
+	return s
+}
+
+// TestWithStructuralSchemaRoundTripsRealReceiver guards against
+// applySchema's unstructured conversion silently wiping Spec/Status because
+// internal.Receiver's Go field names ("Spec", "Status", ...) don't match the
+// schema's lowercase JSON keys ("spec", "status", ...).
+func TestWithStructuralSchemaRoundTripsRealReceiver(t *testing.T) {
+	storage := WithStructuralSchema(&fakeCRUDStorage{}, StructuralSchemaOptions{Schema: receiverSchema(t)})
+	creater, ok := storage.(rest.Creater)
+	if !ok {
+		t.Fatalf("%T does not implement rest.Creater", storage)
+	}
+
+	obj := &internal.Receiver{
+		Spec: internal.ReceiverSpec{
+			Title: "on-call",
+			Integrations: []internal.ReceiverIntegration{
+				{UID: "abc", Type: "email", Settings: map[string]interface{}{"to": "team@example.com"}},
+			},
+		},
+	}
+	out, err := creater.Create(context.Background(), obj, nil, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	got := out.(*internal.Receiver)
+	if got.Spec.Title != "on-call" {
+		t.Fatalf("expected spec.title to survive, got %q", got.Spec.Title)
+	}
+	if len(got.Spec.Integrations) != 1 || got.Spec.Integrations[0].Type != "email" {
+		t.Fatalf("expected spec.integrations to survive, got %+v", got.Spec.Integrations)
+	}
+}
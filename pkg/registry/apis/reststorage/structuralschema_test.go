@@ -0,0 +1,223 @@
+package reststorage
+
+import (
+	"context"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func TestFirstUnknownField(t *testing.T) {
+	full := map[string]interface{}{
+		"title": "a",
+		"nested": map[string]interface{}{
+			"known":   1,
+			"unknown": 2,
+		},
+	}
+	pruned := map[string]interface{}{
+		"title": "a",
+		"nested": map[string]interface{}{
+			"known": 1,
+		},
+	}
+
+	got := firstUnknownField("", full, pruned)
+	if got != "nested.unknown" {
+		t.Fatalf("expected nested.unknown, got %q", got)
+	}
+}
+
+func TestFirstUnknownFieldNoneMissing(t *testing.T) {
+	full := map[string]interface{}{"title": "a"}
+	pruned := map[string]interface{}{"title": "a"}
+
+	if got := firstUnknownField("", full, pruned); got != "" {
+		t.Fatalf("expected no unknown field, got %q", got)
+	}
+}
+
+func TestDeepCopyJSONMapIsIndependent(t *testing.T) {
+	original := map[string]interface{}{
+		"nested": map[string]interface{}{"a": 1},
+	}
+	cpy := deepCopyJSONMap(original)
+	cpy["nested"].(map[string]interface{})["a"] = 2
+
+	if original["nested"].(map[string]interface{})["a"] != 1 {
+		t.Fatalf("deepCopyJSONMap did not produce an independent copy")
+	}
+}
+
+func TestDeepCopyJSONMapIsIndependentForArrayOfObjects(t *testing.T) {
+	original := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"a": 1},
+		},
+	}
+	cpy := deepCopyJSONMap(original)
+	cpy["items"].([]interface{})[0].(map[string]interface{})["a"] = 2
+
+	if original["items"].([]interface{})[0].(map[string]interface{})["a"] != 1 {
+		t.Fatalf("deepCopyJSONMap did not produce an independent copy of an array of objects")
+	}
+}
+
+func TestFirstUnknownFieldInArrayOfObjects(t *testing.T) {
+	full := map[string]interface{}{
+		"integrations": []interface{}{
+			map[string]interface{}{"type": "email", "bogus": true},
+		},
+	}
+	pruned := map[string]interface{}{
+		"integrations": []interface{}{
+			map[string]interface{}{"type": "email"},
+		},
+	}
+
+	got := firstUnknownField("", full, pruned)
+	if got != "integrations[0].bogus" {
+		t.Fatalf("expected integrations[0].bogus, got %q", got)
+	}
+}
+
+// fakeSpec is a minimal Kind spec used to exercise WithStructuralSchema
+// end-to-end: a required, known field (Title) plus an unknown one (Extra)
+// that only ever shows up if pruning/strict validation failed to catch it.
+type fakeSpec struct {
+	Title string `json:"title,omitempty"`
+	Extra string `json:"extra,omitempty"`
+}
+
+type fakeObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              fakeSpec `json:"spec"`
+}
+
+func (o *fakeObject) DeepCopyObject() runtime.Object {
+	cpy := *o
+	return &cpy
+}
+
+func fakeObjectSchema(t *testing.T) *structuralschema.Structural {
+	t.Helper()
+	s, err := NewStructuralSchema(&spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: []string{"object"},
+			Properties: map[string]spec.Schema{
+				"spec": {
+					SchemaProps: spec.SchemaProps{
+						Type: []string{"object"},
+						Properties: map[string]spec.Schema{
+							"title": {SchemaProps: spec.SchemaProps{Type: []string{"string"}}},
+						},
+						Required: []string{"title"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewStructuralSchema: %v", err)
+	}
+	return s
+}
+
+// fakeCRUDStorage is the minimal rest.Creater/rest.Updater stubbed storage
+// WithStructuralSchema wraps in these tests; it just hands back whatever it
+// was given, so assertions run against the object applySchema produced.
+type fakeCRUDStorage struct {
+	rest.Storage
+}
+
+func (f *fakeCRUDStorage) Create(_ context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	return obj, nil
+}
+
+func (f *fakeCRUDStorage) Update(ctx context.Context, _ string, objInfo rest.UpdatedObjectInfo, _ rest.ValidateObjectFunc, _ rest.ValidateObjectUpdateFunc, _ bool, _ *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	newObj, err := objInfo.UpdatedObject(ctx, &fakeObject{})
+	if err != nil {
+		return nil, false, err
+	}
+	return newObj, false, nil
+}
+
+type fakeUpdatedObjectInfo struct {
+	obj runtime.Object
+}
+
+func (i *fakeUpdatedObjectInfo) Preconditions() *metav1.Preconditions { return nil }
+
+func (i *fakeUpdatedObjectInfo) UpdatedObject(_ context.Context, _ runtime.Object) (runtime.Object, error) {
+	return i.obj, nil
+}
+
+func TestWithStructuralSchemaCreatePrunesUnknownFields(t *testing.T) {
+	storage := WithStructuralSchema(&fakeCRUDStorage{}, StructuralSchemaOptions{Schema: fakeObjectSchema(t)})
+	creater, ok := storage.(rest.Creater)
+	if !ok {
+		t.Fatalf("%T does not implement rest.Creater", storage)
+	}
+
+	obj := &fakeObject{Spec: fakeSpec{Title: "a", Extra: "unknown"}}
+	out, err := creater.Create(context.Background(), obj, nil, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	got := out.(*fakeObject)
+	if got.Spec.Title != "a" {
+		t.Fatalf("expected title to survive pruning, got %q", got.Spec.Title)
+	}
+	if got.Spec.Extra != "" {
+		t.Fatalf("expected extra to be pruned, got %q", got.Spec.Extra)
+	}
+}
+
+func TestWithStructuralSchemaCreateStrictRejectsUnknownFields(t *testing.T) {
+	storage := WithStructuralSchema(&fakeCRUDStorage{}, StructuralSchemaOptions{
+		Schema:                fakeObjectSchema(t),
+		StrictFieldValidation: true,
+	})
+	creater := storage.(rest.Creater)
+
+	obj := &fakeObject{Spec: fakeSpec{Title: "a", Extra: "unknown"}}
+	if _, err := creater.Create(context.Background(), obj, nil, &metav1.CreateOptions{}); err == nil {
+		t.Fatal("expected strict field validation to reject the unknown field")
+	}
+}
+
+func TestWithStructuralSchemaCreateRejectsMissingRequiredField(t *testing.T) {
+	storage := WithStructuralSchema(&fakeCRUDStorage{}, StructuralSchemaOptions{Schema: fakeObjectSchema(t)})
+	creater := storage.(rest.Creater)
+
+	obj := &fakeObject{}
+	if _, err := creater.Create(context.Background(), obj, nil, &metav1.CreateOptions{}); err == nil {
+		t.Fatal("expected validation to reject the missing required title field")
+	}
+}
+
+func TestWithStructuralSchemaUpdatePrunesUnknownFields(t *testing.T) {
+	storage := WithStructuralSchema(&fakeCRUDStorage{}, StructuralSchemaOptions{Schema: fakeObjectSchema(t)})
+	updater, ok := storage.(rest.Updater)
+	if !ok {
+		t.Fatalf("%T does not implement rest.Updater", storage)
+	}
+
+	newObj := &fakeObject{Spec: fakeSpec{Title: "b", Extra: "unknown"}}
+	out, _, err := updater.Update(context.Background(), "name", &fakeUpdatedObjectInfo{obj: newObj}, nil, nil, false, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got := out.(*fakeObject)
+	if got.Spec.Title != "b" {
+		t.Fatalf("expected title to survive pruning, got %q", got.Spec.Title)
+	}
+	if got.Spec.Extra != "" {
+		t.Fatalf("expected extra to be pruned, got %q", got.Spec.Extra)
+	}
+}
@@ -0,0 +1,100 @@
+package secret
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// rbacStub stands in for the real RBAC authorizer: it allows a request only
+// if the verb/resource/subresource tuple is in its allow-list, so tests can
+// assert that `get securevalues` never implies `decrypt securevalues/decrypt`.
+type rbacStub struct {
+	allow map[string]bool
+}
+
+func (r *rbacStub) Authorize(_ context.Context, attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	key := attrs.GetVerb() + ":" + attrs.GetResource() + "/" + attrs.GetSubresource()
+	if r.allow[key] {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "not in allow-list", nil
+}
+
+type fixedTenant struct{ tenant string }
+
+func (f fixedTenant) ResolveTenant(_ context.Context, _ string) (string, error) {
+	return f.tenant, nil
+}
+
+func attrsFor(namespace, verb, subresource string, extra map[string][]string) authorizer.Attributes {
+	return authorizer.AttributesRecord{
+		User:            &user.DefaultInfo{Name: "tester", Extra: extra},
+		Verb:            verb,
+		Namespace:       namespace,
+		Resource:        "securevalues",
+		Subresource:     subresource,
+		Name:            "my-secret",
+		ResourceRequest: true,
+	}
+}
+
+func TestSecretAuthorizer_GetDoesNotImplyDecrypt(t *testing.T) {
+	delegate := &rbacStub{allow: map[string]bool{"get:securevalues/": true}}
+	authz := NewAuthorizer(delegate, fixedTenant{tenant: "org-1"})
+
+	extra := map[string][]string{"tenant": {"org-1"}}
+
+	decision, _, err := authz.Authorize(context.Background(), attrsFor("ns-1", "get", "decrypt", extra))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision != authorizer.DecisionDeny {
+		t.Fatalf("expected get securevalues/decrypt to be denied without the decrypt verb, got %v", decision)
+	}
+}
+
+func TestSecretAuthorizer_AllowsDecryptWhenGranted(t *testing.T) {
+	delegate := &rbacStub{allow: map[string]bool{"decrypt:securevalues/decrypt": true}}
+	authz := NewAuthorizer(delegate, fixedTenant{tenant: "org-1"})
+
+	extra := map[string][]string{"tenant": {"org-1"}}
+
+	decision, _, err := authz.Authorize(context.Background(), attrsFor("ns-1", "get", "decrypt", extra))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision != authorizer.DecisionAllow {
+		t.Fatalf("expected decrypt grant to allow the subresource, got %v", decision)
+	}
+}
+
+func TestSecretAuthorizer_RequiresNamespace(t *testing.T) {
+	delegate := &rbacStub{allow: map[string]bool{"get:securevalues/": true}}
+	authz := NewAuthorizer(delegate, nil)
+
+	decision, _, err := authz.Authorize(context.Background(), attrsFor("", "get", "", nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision != authorizer.DecisionDeny {
+		t.Fatalf("expected cluster-scoped securevalues access to be denied, got %v", decision)
+	}
+}
+
+func TestSecretAuthorizer_RejectsTenantMismatch(t *testing.T) {
+	delegate := &rbacStub{allow: map[string]bool{"get:securevalues/": true}}
+	authz := NewAuthorizer(delegate, fixedTenant{tenant: "org-1"})
+
+	extra := map[string][]string{"tenant": {"org-2"}}
+
+	decision, _, err := authz.Authorize(context.Background(), attrsFor("ns-1", "get", "", extra))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision != authorizer.DecisionDeny {
+		t.Fatalf("expected mismatched tenant to be denied, got %v", decision)
+	}
+}
@@ -0,0 +1,116 @@
+package secret
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/registry/rest"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	common "k8s.io/kube-openapi/pkg/common"
+
+	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+	"github.com/grafana/grafana/pkg/registry/apis/secret/reststorage"
+	"github.com/grafana/grafana/pkg/services/apiserver/builder"
+	"github.com/grafana/grafana/pkg/services/featuremgmt"
+	"github.com/grafana/grafana/pkg/setting"
+)
+
+var _ builder.APIGroupBuilder = (*SecretDecryptAPIBuilder)(nil)
+
+// SecretDecryptAPIBuilder serves only the `securevalues/decrypt` and
+// `securevalues/history` subresources, split out of SecretAPIBuilder so
+// operators can run the decrypt endpoint on its own genericapiserver
+// instance: a different audit policy, a different TLS cert, and a network
+// exposure that doesn't have to match the management CRUDL API.
+type SecretDecryptAPIBuilder struct {
+	config     *setting.Cfg
+	store      reststorage.DecryptStore
+	authorizer authorizer.Authorizer
+	tenants    TenantResolver
+}
+
+func NewSecretDecryptAPIBuilder(
+	config *setting.Cfg,
+	store reststorage.DecryptStore,
+	delegate authorizer.Authorizer,
+	tenants TenantResolver,
+) *SecretDecryptAPIBuilder {
+	return &SecretDecryptAPIBuilder{config: config, store: store, authorizer: delegate, tenants: tenants}
+}
+
+func RegisterDecryptAPIService(
+	config *setting.Cfg,
+	features featuremgmt.FeatureToggles,
+	apiregistration builder.APIRegistrar,
+	store reststorage.DecryptStore,
+	delegate authorizer.Authorizer,
+	tenants TenantResolver,
+) *SecretDecryptAPIBuilder {
+	// Runs behind its own flag, independent of FlagSecretsManagementAppPlatform,
+	// so an operator can stand up the decrypt-only apiserver without also
+	// exposing the management CRUDL API, or vice versa.
+	if !features.IsEnabledGlobally(featuremgmt.FlagGrafanaAPIServerWithExperimentalAPIs) ||
+		!features.IsEnabledGlobally(featuremgmt.FlagSecretsManagementDecryptAPIServer) {
+		return nil
+	}
+
+	b := NewSecretDecryptAPIBuilder(config, store, delegate, tenants)
+	apiregistration.RegisterAPI(b)
+	return b
+}
+
+// GetGroupVersion returns the same group/version SecretAPIBuilder serves:
+// both builders answer for `securevalues`, just on different storage paths.
+func (b *SecretDecryptAPIBuilder) GetGroupVersion() schema.GroupVersion {
+	return secretV1Alpha1.SchemeGroupVersion
+}
+
+// InstallSchema mirrors SecretAPIBuilder's own registration. Both builders
+// install the same types and version priority onto their respective
+// scheme, so whichever genericapiserver instance answers a request, clients
+// see one coherent `securevalues` group.
+func (b *SecretDecryptAPIBuilder) InstallSchema(scheme *runtime.Scheme) error {
+	secretV1Alpha1.AddKnownTypes(scheme, secretV1Alpha1.VERSION)
+	secretV1Alpha1.AddKnownTypes(scheme, runtime.APIVersionInternal)
+	metav1.AddToGroupVersion(scheme, secretV1Alpha1.SchemeGroupVersion)
+
+	if err := scheme.SetVersionPriority(secretV1Alpha1.SchemeGroupVersion); err != nil {
+		return fmt.Errorf("scheme set version priority: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAPIGroupInfo registers only the decrypt/history subresource storage;
+// SecretAPIBuilder continues to own secureValueResource.StoragePath() itself.
+func (b *SecretDecryptAPIBuilder) UpdateAPIGroupInfo(apiGroupInfo *genericapiserver.APIGroupInfo, _ builder.APIGroupOptions) error {
+	secureValueResource := secretV1Alpha1.SecureValuesResourceInfo
+
+	storageMap := map[string]rest.Storage{
+		secureValueResource.StoragePath("decrypt"): reststorage.NewDecryptStorage(b.config, b.store, b.GetAuthorizer()),
+		secureValueResource.StoragePath("history"): reststorage.NewHistoryStorage(b.store, b.GetAuthorizer()),
+	}
+
+	apiGroupInfo.VersionedResourcesStorageMap[secretV1Alpha1.VERSION] = storageMap
+	return nil
+}
+
+func (b *SecretDecryptAPIBuilder) GetOpenAPIDefinitions() common.GetOpenAPIDefinitions {
+	return secretV1Alpha1.GetOpenAPIDefinitions
+}
+
+// GetAuthorizer applies the same namespace/tenant/decrypt-verb invariants as
+// SecretAPIBuilder's, since the two builders serve the same RBAC surface.
+func (b *SecretDecryptAPIBuilder) GetAuthorizer() authorizer.Authorizer {
+	if b.authorizer == nil {
+		return nil
+	}
+	return NewAuthorizer(b.authorizer, b.tenants)
+}
+
+func (b *SecretDecryptAPIBuilder) GetAPIRoutes() *builder.APIRoutes {
+	return nil
+}
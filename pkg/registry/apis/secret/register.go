@@ -3,6 +3,7 @@ package secret
 import (
 	"fmt"
 
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -10,8 +11,10 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	common "k8s.io/kube-openapi/pkg/common"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
 
 	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+	sharedreststorage "github.com/grafana/grafana/pkg/registry/apis/reststorage"
 	"github.com/grafana/grafana/pkg/registry/apis/secret/reststorage"
 	"github.com/grafana/grafana/pkg/services/apiserver/builder"
 	"github.com/grafana/grafana/pkg/services/featuremgmt"
@@ -22,13 +25,23 @@ import (
 var _ builder.APIGroupBuilder = (*SecretAPIBuilder)(nil)
 
 type SecretAPIBuilder struct {
-	config  *setting.Cfg
-	store   secretstore.SecureValueStore
-	manager secretstore.SecretManager
+	config     *setting.Cfg
+	features   featuremgmt.FeatureToggles
+	store      secretstore.SecureValueStore
+	manager    secretstore.SecretManager
+	authorizer authorizer.Authorizer
+	tenants    TenantResolver
 }
 
-func NewSecretAPIBuilder(config *setting.Cfg, store secretstore.SecureValueStore, manager secretstore.SecretManager) *SecretAPIBuilder {
-	return &SecretAPIBuilder{config, store, manager}
+func NewSecretAPIBuilder(
+	config *setting.Cfg,
+	features featuremgmt.FeatureToggles,
+	store secretstore.SecureValueStore,
+	manager secretstore.SecretManager,
+	delegate authorizer.Authorizer,
+	tenants TenantResolver,
+) *SecretAPIBuilder {
+	return &SecretAPIBuilder{config, features, store, manager, delegate, tenants}
 }
 
 func RegisterAPIService(
@@ -37,6 +50,8 @@ func RegisterAPIService(
 	apiregistration builder.APIRegistrar,
 	store secretstore.SecureValueStore,
 	manager secretstore.SecretManager,
+	delegate authorizer.Authorizer,
+	tenants TenantResolver,
 ) *SecretAPIBuilder {
 	// Skip registration unless opting into experimental apis and the secrets management app platform flag.
 	if !features.IsEnabledGlobally(featuremgmt.FlagGrafanaAPIServerWithExperimentalAPIs) ||
@@ -44,7 +59,7 @@ func RegisterAPIService(
 		return nil
 	}
 
-	builder := NewSecretAPIBuilder(config, store, manager)
+	builder := NewSecretAPIBuilder(config, features, store, manager, delegate, tenants)
 	apiregistration.RegisterAPI(builder)
 	return builder
 }
@@ -85,34 +100,57 @@ func (b *SecretAPIBuilder) InstallSchema(scheme *runtime.Scheme) error {
 func (b *SecretAPIBuilder) UpdateAPIGroupInfo(apiGroupInfo *genericapiserver.APIGroupInfo, opts builder.APIGroupOptions) error {
 	secureValueResource := secretV1Alpha1.SecureValuesResourceInfo
 
+	secureValueStorage := reststorage.NewGenericStorage(b.store, secureValueResource)
+
+	var securevalueCRUDL rest.Storage = secureValueStorage
+	if schema, schemaErr := b.structuralSchema(); schemaErr != nil {
+		return fmt.Errorf("secure value structural schema: %w", schemaErr)
+	} else if schema != nil {
+		securevalueCRUDL = sharedreststorage.WithStructuralSchema(secureValueStorage, sharedreststorage.StructuralSchemaOptions{
+			Schema:                schema,
+			StrictFieldValidation: b.features.IsEnabledGlobally(featuremgmt.FlagSecretsManagementStrictFieldValidation),
+		})
+	}
+
 	// rest.Storage is a generic interface for RESTful storage services.
 	// The constructors need to at least implement this interface, but will most likely implement
 	// other interfaces that equal to different operations like `get`, `list` and so on.
-	secureValueStorage := map[string]rest.Storage{
+	//
+	// `securevalue/decrypt` and `securevalue/history` are served by
+	// SecretDecryptAPIBuilder instead of here, so operators can run the
+	// decrypt endpoint on a separate genericapiserver instance from CRUDL.
+	storageMap := map[string]rest.Storage{
 		// Default path for `securevalue`.
 		// The `reststorage.GenericStorage` struct will implement interfaces for CRUDL operations on `securevalue`.
-		secureValueResource.StoragePath(): reststorage.NewGenericStorage(b.store, secureValueResource),
-
-		// This is a subresource from `securevalue`. It gets accessed like `securevalue/xyz/decrypt`.
-		// Not yet supported by grafana-app-sdk or unified storage.
-		secureValueResource.StoragePath("decrypt"): reststorage.NewDecryptStorage(b.config, b.store),
-
-		// This is a subresrouce from `securevalue`. It gets accessed like `securevalue/xyz/history`.
-		// Not yet supported by grafana-app-sdk or unified storage.
-		secureValueResource.StoragePath("history"): reststorage.NewHistoryStorage(b.store),
+		secureValueResource.StoragePath(): securevalueCRUDL,
 	}
 
 	// This does not do anything here. Shouldn't it also use the keymanager resource? TODO!
-	err := b.manager.InitStorage(opts.Scheme, secureValueStorage, opts.OptsGetter)
+	err := b.manager.InitStorage(opts.Scheme, storageMap, opts.OptsGetter)
 	if err != nil {
 		return fmt.Errorf("secret manager init storage: %w", err)
 	}
 
 	// apiGroupInfo.VersionedResourcesStorageMap[secret.VERSION] = secureValueStorage
-	apiGroupInfo.VersionedResourcesStorageMap[secretV1Alpha1.VERSION] = secureValueStorage
+	apiGroupInfo.VersionedResourcesStorageMap[secretV1Alpha1.VERSION] = storageMap
 	return nil
 }
 
+// structuralSchema derives the structural schema SecureValue is validated,
+// pruned and defaulted against from its generated OpenAPI v3 definition.
+// Returns (nil, nil) if the Kind doesn't publish a definition under its own
+// name, so schema enforcement degrades gracefully instead of failing install.
+func (b *SecretAPIBuilder) structuralSchema() (*structuralschema.Structural, error) {
+	defs := secretV1Alpha1.GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.Ref{}
+	})
+	def, ok := defs["github.com/grafana/grafana/pkg/apis/secret/v1alpha1.SecureValue"]
+	if !ok {
+		return nil, nil
+	}
+	return sharedreststorage.NewStructuralSchema(&def.Schema)
+}
+
 // GetOpenAPIDefinitions, is this only for documentation?
 func (b *SecretAPIBuilder) GetOpenAPIDefinitions() common.GetOpenAPIDefinitions {
 	// defs := make(map[string]common.OpenAPIDefinition, 0)
@@ -129,11 +167,17 @@ func (b *SecretAPIBuilder) GetOpenAPIDefinitions() common.GetOpenAPIDefinitions
 	return secretV1Alpha1.GetOpenAPIDefinitions
 }
 
-// GetAuthorizer: [TODO] who can create secrets? must be multi-tenant first
+// GetAuthorizer requires every `securevalues` request to be namespaced and
+// the caller's tenant to match that namespace, and additionally requires the
+// `decrypt` verb (distinct from `get`) on the `securevalues/decrypt`
+// subresource, before deferring to the default authorizer chain. Returns nil
+// (use the default authorizer) if no delegate was configured, matching the
+// previous behavior for installs that haven't wired one in yet.
 func (b *SecretAPIBuilder) GetAuthorizer() authorizer.Authorizer {
-	// This is TBD being defined with IAM.
-
-	return nil // start with the default authorizer
+	if b.authorizer == nil {
+		return nil
+	}
+	return NewAuthorizer(b.authorizer, b.tenants)
 }
 
 // Register additional routes with the server.
@@ -0,0 +1,48 @@
+package reststorage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+	"github.com/grafana/grafana/pkg/setting"
+)
+
+// DecryptStorage implements the `securevalue/decrypt` subresource.
+type DecryptStorage struct {
+	config *setting.Cfg
+	store  DecryptStore
+	authz  authorizer.Authorizer
+}
+
+func NewDecryptStorage(config *setting.Cfg, store DecryptStore, authz authorizer.Authorizer) *DecryptStorage {
+	return &DecryptStorage{config: config, store: store, authz: authz}
+}
+
+func (s *DecryptStorage) New() runtime.Object {
+	return &secretV1Alpha1.SecureValue{}
+}
+
+func (s *DecryptStorage) Destroy() {}
+
+var (
+	_ rest.Storage = (*DecryptStorage)(nil)
+	_ rest.Getter  = (*DecryptStorage)(nil)
+)
+
+// Get returns the decrypted plaintext for name. It re-checks authorization
+// against the dedicated `decrypt` verb before calling the store, rather than
+// trusting whatever let the request reach this subresource's storage: the
+// parent `securevalues` authorization chain only ever proves `get`, which
+// must never be treated as proof of decrypt access.
+func (s *DecryptStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	if err := authorizeSubresource(ctx, s.authz, name, "decrypt", "decrypt"); err != nil {
+		return nil, err
+	}
+	return s.store.Decrypt(ctx, request.NamespaceValue(ctx), name)
+}
@@ -0,0 +1,18 @@
+package reststorage
+
+import (
+	"context"
+
+	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+)
+
+// DecryptStore is the narrow slice of secretstore.SecureValueStore that
+// DecryptStorage and HistoryStorage need. Keeping it separate from the full
+// store interface lets SecretDecryptAPIBuilder be wired to an implementation
+// that can read and decrypt SecureValues but has no way to create, update or
+// delete them, so a decrypt-only genericapiserver instance can't mutate data.
+type DecryptStore interface {
+	Decrypt(ctx context.Context, namespace, name string) (*secretV1Alpha1.SecureValue, error)
+	Read(ctx context.Context, namespace, name string) (*secretV1Alpha1.SecureValue, error)
+	ListHistory(ctx context.Context, namespace, name string) (*secretV1Alpha1.SecureValueList, error)
+}
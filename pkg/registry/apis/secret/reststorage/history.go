@@ -0,0 +1,44 @@
+package reststorage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+)
+
+// HistoryStorage implements the `securevalue/history` subresource.
+type HistoryStorage struct {
+	store DecryptStore
+	authz authorizer.Authorizer
+}
+
+func NewHistoryStorage(store DecryptStore, authz authorizer.Authorizer) *HistoryStorage {
+	return &HistoryStorage{store: store, authz: authz}
+}
+
+func (s *HistoryStorage) New() runtime.Object {
+	return &secretV1Alpha1.SecureValueList{}
+}
+
+func (s *HistoryStorage) Destroy() {}
+
+var (
+	_ rest.Storage = (*HistoryStorage)(nil)
+	_ rest.Getter  = (*HistoryStorage)(nil)
+)
+
+// Get returns the revision history for name, re-checking authorization
+// against the `securevalues/history` subresource rather than relying on
+// whatever authorized the request for the parent `securevalues` resource.
+func (s *HistoryStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	if err := authorizeSubresource(ctx, s.authz, name, "history", "get"); err != nil {
+		return nil, err
+	}
+	return s.store.ListHistory(ctx, request.NamespaceValue(ctx), name)
+}
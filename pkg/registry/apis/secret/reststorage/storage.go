@@ -0,0 +1,173 @@
+package reststorage
+
+import (
+	"context"
+	"fmt"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	secretV1Alpha1 "github.com/grafana/grafana/pkg/apis/secret/v1alpha1"
+	sharedreststorage "github.com/grafana/grafana/pkg/registry/apis/reststorage"
+	secretstore "github.com/grafana/grafana/pkg/storage/secret"
+)
+
+// GenericStorage implements the CRUDL rest.Storage interfaces for
+// `securevalue` on top of the store.
+type GenericStorage struct {
+	store        secretstore.SecureValueStore
+	resourceInfo sharedreststorage.MetadataOnlyResourceInfo
+}
+
+func NewGenericStorage(store secretstore.SecureValueStore, resourceInfo sharedreststorage.MetadataOnlyResourceInfo) *GenericStorage {
+	return &GenericStorage{store: store, resourceInfo: resourceInfo}
+}
+
+func (s *GenericStorage) New() runtime.Object {
+	return &secretV1Alpha1.SecureValue{}
+}
+
+func (s *GenericStorage) NewList() runtime.Object {
+	return &secretV1Alpha1.SecureValueList{}
+}
+
+func (s *GenericStorage) Destroy() {}
+
+func (s *GenericStorage) NamespaceScoped() bool {
+	return true
+}
+
+var (
+	_ rest.Storage         = (*GenericStorage)(nil)
+	_ rest.Scoper          = (*GenericStorage)(nil)
+	_ rest.Getter          = (*GenericStorage)(nil)
+	_ rest.Lister          = (*GenericStorage)(nil)
+	_ rest.Creater         = (*GenericStorage)(nil)
+	_ rest.Updater         = (*GenericStorage)(nil)
+	_ rest.GracefulDeleter = (*GenericStorage)(nil)
+)
+
+// Get returns the SecureValue, or its PartialObjectMetadata projection when
+// the caller's Accept header asked for `as=PartialObjectMetadata`, so
+// metadata-only watchers (GC, finalizer sweeps, cross-resource indexers)
+// never pull Spec over the wire.
+func (s *GenericStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := s.store.Read(ctx, request.NamespaceValue(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+	// s.store returns the versioned *secretV1Alpha1.SecureValue directly
+	// (there's no hub/internal type here), but it doesn't populate its
+	// TypeMeta; stamp it here so a non-partial Get response still reports a
+	// real GVK.
+	obj.GetObjectKind().SetGroupVersionKind(s.resourceInfo.GroupVersionKind())
+	if !sharedreststorage.AcceptsPartialObjectMetadata(ctx) {
+		return obj, nil
+	}
+	return sharedreststorage.ToPartialObjectMetadata(obj)
+}
+
+// List returns SecureValues, honoring the same `as=PartialObjectMetadata`
+// Accept header as Get, applied to every item.
+func (s *GenericStorage) List(ctx context.Context, _ *metainternalversion.ListOptions) (runtime.Object, error) {
+	list, err := s.store.List(ctx, request.NamespaceValue(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		list.Items[i].GetObjectKind().SetGroupVersionKind(s.resourceInfo.GroupVersionKind())
+	}
+	if !sharedreststorage.AcceptsPartialObjectMetadata(ctx) {
+		return list, nil
+	}
+
+	out := &metav1.PartialObjectMetadataList{}
+	for i := range list.Items {
+		partial, err := sharedreststorage.ToPartialObjectMetadata(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, *partial)
+	}
+	return out, nil
+}
+
+// Create persists a new SecureValue, letting Create/Update/PATCH
+// (server-side-apply) actually reach s.store instead of only the read paths
+// above.
+func (s *GenericStorage) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	secureValue, ok := obj.(*secretV1Alpha1.SecureValue)
+	if !ok {
+		return nil, fmt.Errorf("expected *secretV1Alpha1.SecureValue, got %T", obj)
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, secureValue); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.Create(ctx, secureValue)
+}
+
+// Update applies objInfo to the current SecureValue (or to nil, when
+// forceAllowCreate is set and name doesn't exist yet) and persists the
+// result, creating it if it didn't already exist.
+func (s *GenericStorage) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, _ *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	old, err := s.store.Read(ctx, request.NamespaceValue(ctx), name)
+	var oldObj runtime.Object
+	if err != nil {
+		if !forceAllowCreate {
+			return nil, false, err
+		}
+		old = nil
+	} else {
+		oldObj = old
+	}
+
+	newObj, err := objInfo.UpdatedObject(ctx, oldObj)
+	if err != nil {
+		return nil, false, err
+	}
+	secureValue, ok := newObj.(*secretV1Alpha1.SecureValue)
+	if !ok {
+		return nil, false, fmt.Errorf("expected *secretV1Alpha1.SecureValue, got %T", newObj)
+	}
+
+	if old == nil {
+		if createValidation != nil {
+			if err := createValidation(ctx, secureValue); err != nil {
+				return nil, false, err
+			}
+		}
+		created, err := s.store.Create(ctx, secureValue)
+		return created, true, err
+	}
+
+	if updateValidation != nil {
+		if err := updateValidation(ctx, secureValue, old); err != nil {
+			return nil, false, err
+		}
+	}
+	updated, err := s.store.Update(ctx, secureValue)
+	return updated, false, err
+}
+
+// Delete removes the SecureValue, returning the object that was deleted so
+// clients (and admission) can see what went away.
+func (s *GenericStorage) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, _ *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	obj, err := s.store.Read(ctx, request.NamespaceValue(ctx), name)
+	if err != nil {
+		return nil, false, err
+	}
+	if deleteValidation != nil {
+		if err := deleteValidation(ctx, obj); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := s.store.Delete(ctx, request.NamespaceValue(ctx), name); err != nil {
+		return nil, false, err
+	}
+	return obj, true, nil
+}
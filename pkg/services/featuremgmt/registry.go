@@ -0,0 +1,40 @@
+package featuremgmt
+
+// FeatureFlag is the registry entry toggles_gen.go's constants are generated
+// from; it carries the metadata (stage, owning team, description) that
+// doesn't belong on the generated constant itself.
+type FeatureFlag struct {
+	Name        string
+	Description string
+	Stage       FeatureStage
+	Owner       string
+}
+
+// FeatureStage mirrors the maturity levels Grafana's other feature toggles
+// are staged through on their way to general availability.
+type FeatureStage int
+
+const (
+	FeatureStageExperimental FeatureStage = iota
+	FeatureStagePrivatePreview
+	FeatureStagePublicPreview
+	FeatureStageGeneralAvailability
+)
+
+// secretsManagementFeatureFlags are the toggles gating the SecureValue and
+// Receiver app-platform APIs registered under pkg/registry/apis/secret and
+// pkg/registry/apis/alerting/receiver.
+var secretsManagementFeatureFlags = []FeatureFlag{
+	{
+		Name:        "secretsManagementStrictFieldValidation",
+		Description: "Reject unknown fields on SecureValue/Receiver writes instead of silently pruning them",
+		Stage:       FeatureStageExperimental,
+		Owner:       "grafana-secrets-management",
+	},
+	{
+		Name:        "secretsManagementDecryptAPIServer",
+		Description: "Serve securevalue/decrypt and securevalue/history on their own API server, separate from SecureValue CRUDL",
+		Stage:       FeatureStageExperimental,
+		Owner:       "grafana-secrets-management",
+	},
+}
@@ -0,0 +1,15 @@
+// NOTE: This file is autogenerated from registry.go. Run `make gen-feature-toggles` to regenerate it.
+
+package featuremgmt
+
+const (
+	// FlagSecretsManagementStrictFieldValidation
+	// Reject unknown fields on SecureValue/Receiver writes instead of
+	// silently pruning them.
+	FlagSecretsManagementStrictFieldValidation = "secretsManagementStrictFieldValidation"
+
+	// FlagSecretsManagementDecryptAPIServer
+	// Serve securevalue/decrypt and securevalue/history on their own API
+	// server, separate from SecureValue CRUDL.
+	FlagSecretsManagementDecryptAPIServer = "secretsManagementDecryptAPIServer"
+)